@@ -0,0 +1,231 @@
+package null
+
+import (
+	"database/sql"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+// TestConversionMatchesDatabaseSQL checks that xorm's FromDB/ToDB give the
+// same result as the database/sql Scan/Value path for the same values, so
+// switching between the two doesn't change null handling. It exercises both
+// code paths directly; TestConversionAgainstSQLite below exercises the same
+// pair against a real database connection.
+func TestConversionMatchesDatabaseSQL(t *testing.T) {
+	t.Run("Uint", func(t *testing.T) {
+		want := UintFrom(42)
+		dbValue, err := want.Value()
+		if err != nil {
+			t.Fatalf("Value: %v", err)
+		}
+
+		var viaScan Uint
+		if err := viaScan.Scan(dbValue); err != nil {
+			t.Fatalf("Scan: %v", err)
+		}
+
+		dbBytes, err := want.ToDB()
+		if err != nil {
+			t.Fatalf("ToDB: %v", err)
+		}
+		var viaFromDB Uint
+		if err := viaFromDB.FromDB(dbBytes); err != nil {
+			t.Fatalf("FromDB: %v", err)
+		}
+
+		if viaScan.Uint != viaFromDB.Uint || viaScan.Valid != viaFromDB.Valid {
+			t.Errorf("Scan = %+v, FromDB = %+v; want equal", viaScan, viaFromDB)
+		}
+	})
+
+	t.Run("Uint64", func(t *testing.T) {
+		want := Uint64From(42)
+		dbValue, err := want.Value()
+		if err != nil {
+			t.Fatalf("Value: %v", err)
+		}
+
+		var viaScan Uint64
+		if err := viaScan.Scan(dbValue); err != nil {
+			t.Fatalf("Scan: %v", err)
+		}
+
+		dbBytes, err := want.ToDB()
+		if err != nil {
+			t.Fatalf("ToDB: %v", err)
+		}
+		var viaFromDB Uint64
+		if err := viaFromDB.FromDB(dbBytes); err != nil {
+			t.Fatalf("FromDB: %v", err)
+		}
+
+		if viaScan.Uint64 != viaFromDB.Uint64 || viaScan.Valid != viaFromDB.Valid {
+			t.Errorf("Scan = %+v, FromDB = %+v; want equal", viaScan, viaFromDB)
+		}
+	})
+
+	t.Run("Int16", func(t *testing.T) {
+		want := Int16From(-7)
+		dbValue, err := want.Value()
+		if err != nil {
+			t.Fatalf("Value: %v", err)
+		}
+
+		var viaScan Int16
+		if err := viaScan.Scan(dbValue); err != nil {
+			t.Fatalf("Scan: %v", err)
+		}
+
+		dbBytes, err := want.ToDB()
+		if err != nil {
+			t.Fatalf("ToDB: %v", err)
+		}
+		var viaFromDB Int16
+		if err := viaFromDB.FromDB(dbBytes); err != nil {
+			t.Fatalf("FromDB: %v", err)
+		}
+
+		if viaScan.Int16 != viaFromDB.Int16 || viaScan.Valid != viaFromDB.Valid {
+			t.Errorf("Scan = %+v, FromDB = %+v; want equal", viaScan, viaFromDB)
+		}
+	})
+}
+
+func TestConversionNullRoundTrip(t *testing.T) {
+	var u Uint
+	u.Valid, u.set = true, true // start non-null so FromDB(nil) exercises the reset path
+	if err := u.FromDB(nil); err != nil {
+		t.Fatalf("FromDB(nil): %v", err)
+	}
+	if u.Valid || u.IsSet() {
+		t.Errorf("FromDB(nil) = %+v; want Valid=false, IsSet=false", u)
+	}
+
+	b, err := u.ToDB()
+	if err != nil {
+		t.Fatalf("ToDB: %v", err)
+	}
+	if b != nil {
+		t.Errorf("ToDB() on invalid Uint = %v; want nil", b)
+	}
+}
+
+// TestConversionAgainstSQLite exercises both Scan/Value (via database/sql,
+// as a driver actually drives them) and FromDB/ToDB (as an ORM like xorm
+// drives them, against the raw column bytes) over a real sqlite connection,
+// for both a present and a null value.
+func TestConversionAgainstSQLite(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(`CREATE TABLE widgets (
+		id INTEGER PRIMARY KEY,
+		u  INTEGER,
+		u64 INTEGER,
+		i16 INTEGER
+	)`); err != nil {
+		t.Fatalf("CREATE TABLE: %v", err)
+	}
+
+	present := struct {
+		u   Uint
+		u64 Uint64
+		i16 Int16
+	}{UintFrom(42), Uint64From(1 << 40), Int16From(-7)}
+
+	uVal, err := present.u.Value()
+	if err != nil {
+		t.Fatalf("Uint.Value: %v", err)
+	}
+	u64Val, err := present.u64.Value()
+	if err != nil {
+		t.Fatalf("Uint64.Value: %v", err)
+	}
+	i16Val, err := present.i16.Value()
+	if err != nil {
+		t.Fatalf("Int16.Value: %v", err)
+	}
+
+	if _, err := db.Exec(`INSERT INTO widgets (id, u, u64, i16) VALUES (1, ?, ?, ?), (2, NULL, NULL, NULL)`,
+		uVal, u64Val, i16Val); err != nil {
+		t.Fatalf("INSERT: %v", err)
+	}
+
+	t.Run("Scan", func(t *testing.T) {
+		var u Uint
+		var u64 Uint64
+		var i16 Int16
+		row := db.QueryRow(`SELECT u, u64, i16 FROM widgets WHERE id = 1`)
+		if err := row.Scan(&u, &u64, &i16); err != nil {
+			t.Fatalf("Scan present row: %v", err)
+		}
+		if u.Uint != 42 || !u.Valid {
+			t.Errorf("u = %+v; want {42 true}", u)
+		}
+		if u64.Uint64 != 1<<40 || !u64.Valid {
+			t.Errorf("u64 = %+v; want {%d true}", u64, uint64(1<<40))
+		}
+		if i16.Int16 != -7 || !i16.Valid {
+			t.Errorf("i16 = %+v; want {-7 true}", i16)
+		}
+
+		row = db.QueryRow(`SELECT u, u64, i16 FROM widgets WHERE id = 2`)
+		if err := row.Scan(&u, &u64, &i16); err != nil {
+			t.Fatalf("Scan null row: %v", err)
+		}
+		if u.Valid || u64.Valid || i16.Valid {
+			t.Errorf("null row scanned as valid: u=%+v u64=%+v i16=%+v", u, u64, i16)
+		}
+	})
+
+	t.Run("FromDB", func(t *testing.T) {
+		var uBytes, u64Bytes, i16Bytes []byte
+		row := db.QueryRow(`SELECT u, u64, i16 FROM widgets WHERE id = 1`)
+		if err := row.Scan(&uBytes, &u64Bytes, &i16Bytes); err != nil {
+			t.Fatalf("Scan raw bytes, present row: %v", err)
+		}
+
+		var u Uint
+		var u64 Uint64
+		var i16 Int16
+		if err := u.FromDB(uBytes); err != nil {
+			t.Fatalf("Uint.FromDB: %v", err)
+		}
+		if err := u64.FromDB(u64Bytes); err != nil {
+			t.Fatalf("Uint64.FromDB: %v", err)
+		}
+		if err := i16.FromDB(i16Bytes); err != nil {
+			t.Fatalf("Int16.FromDB: %v", err)
+		}
+		if u.Uint != 42 || !u.Valid {
+			t.Errorf("u = %+v; want {42 true}", u)
+		}
+		if u64.Uint64 != 1<<40 || !u64.Valid {
+			t.Errorf("u64 = %+v; want {%d true}", u64, uint64(1<<40))
+		}
+		if i16.Int16 != -7 || !i16.Valid {
+			t.Errorf("i16 = %+v; want {-7 true}", i16)
+		}
+
+		row = db.QueryRow(`SELECT u, u64, i16 FROM widgets WHERE id = 2`)
+		if err := row.Scan(&uBytes, &u64Bytes, &i16Bytes); err != nil {
+			t.Fatalf("Scan raw bytes, null row: %v", err)
+		}
+		if err := u.FromDB(uBytes); err != nil {
+			t.Fatalf("Uint.FromDB(null): %v", err)
+		}
+		if err := u64.FromDB(u64Bytes); err != nil {
+			t.Fatalf("Uint64.FromDB(null): %v", err)
+		}
+		if err := i16.FromDB(i16Bytes); err != nil {
+			t.Fatalf("Int16.FromDB(null): %v", err)
+		}
+		if u.Valid || u64.Valid || i16.Valid {
+			t.Errorf("null row FromDB'd as valid: u=%+v u64=%+v i16=%+v", u, u64, i16)
+		}
+	})
+}
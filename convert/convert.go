@@ -0,0 +1,107 @@
+// Package convert assigns values produced by a database/sql driver into
+// the differently-typed fields the null package's Scan methods need,
+// analogous to database/sql's internal convertAssign.
+package convert
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ConvertAssign copies src into the value pointed to by dest, converting
+// between compatible numeric and string types as needed.
+func ConvertAssign(dest, src interface{}) error {
+	dv := reflect.ValueOf(dest)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() {
+		return fmt.Errorf("convert: destination must be a non-nil pointer, got %T", dest)
+	}
+	dv = dv.Elem()
+
+	switch s := src.(type) {
+	case int64:
+		return assignInt(dv, s)
+	case uint64:
+		return assignUint(dv, s)
+	case float64:
+		return assignInt(dv, int64(s))
+	case bool:
+		return assignInt(dv, boolToInt64(s))
+	case []byte:
+		return assignString(dv, string(s))
+	case string:
+		return assignString(dv, s)
+	case nil:
+		dv.Set(reflect.Zero(dv.Type()))
+		return nil
+	case driver.Valuer:
+		v, err := s.Value()
+		if err != nil {
+			return err
+		}
+		return ConvertAssign(dest, v)
+	default:
+		return fmt.Errorf("convert: unsupported source type %T", src)
+	}
+}
+
+func boolToInt64(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func assignInt(dv reflect.Value, n int64) error {
+	switch dv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		dv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n < 0 {
+			return fmt.Errorf("convert: cannot assign negative value %d to %s", n, dv.Type())
+		}
+		dv.SetUint(uint64(n))
+		return nil
+	default:
+		return fmt.Errorf("convert: cannot assign %d to %s", n, dv.Type())
+	}
+}
+
+func assignUint(dv reflect.Value, n uint64) error {
+	switch dv.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		dv.SetUint(n)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		dv.SetInt(int64(n))
+		return nil
+	default:
+		return fmt.Errorf("convert: cannot assign %d to %s", n, dv.Type())
+	}
+}
+
+func assignString(dv reflect.Value, s string) error {
+	switch dv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, dv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dv.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, dv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dv.SetUint(n)
+		return nil
+	case reflect.String:
+		dv.SetString(s)
+		return nil
+	default:
+		return fmt.Errorf("convert: cannot assign %q to %s", s, dv.Type())
+	}
+}
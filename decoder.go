@@ -0,0 +1,77 @@
+package null
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Decoder reads a stream of JSON-encoded values containing null types,
+// analogous to json.Decoder and paired with Encoder below. Within a
+// decoded record, a field left absent from the input leaves its IsSet()
+// false, an explicit JSON null leaves IsSet() true and Valid false, and
+// a present value leaves both true; that distinction comes entirely
+// from each null type's UnmarshalJSON running (or not) during Decode.
+// Decoder itself exists to expose json.Decoder's streaming surface
+// (More, DecodeToken) so arrays or newline-delimited JSON can be walked
+// without buffering the whole document in memory.
+type Decoder struct {
+	dec *json.Decoder
+}
+
+// NewDecoder returns a new Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{dec: json.NewDecoder(r)}
+}
+
+// Decode reads the next JSON-encoded value from its input and stores it
+// in the value pointed to by v, same as json.Decoder.Decode.
+func (d *Decoder) Decode(v interface{}) error {
+	return d.dec.Decode(v)
+}
+
+// More reports whether there is another element in the current array or
+// object being parsed, same as json.Decoder.More.
+func (d *Decoder) More() bool {
+	return d.dec.More()
+}
+
+// DecodeToken returns the next JSON token in the input stream, same as
+// json.Decoder.Token.
+func (d *Decoder) DecodeToken() (json.Token, error) {
+	return d.dec.Token()
+}
+
+// UseNumber causes the Decoder to unmarshal numbers into an
+// interface{} as a json.Number instead of as a float64.
+func (d *Decoder) UseNumber() {
+	d.dec.UseNumber()
+}
+
+// Encoder writes a stream of JSON-encoded values containing null types,
+// analogous to json.Encoder and the write-side counterpart to Decoder.
+type Encoder struct {
+	enc *json.Encoder
+}
+
+// NewEncoder returns a new Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{enc: json.NewEncoder(w)}
+}
+
+// Encode writes the JSON encoding of v to the stream, followed by a
+// newline, same as json.Encoder.Encode.
+func (e *Encoder) Encode(v interface{}) error {
+	return e.enc.Encode(v)
+}
+
+// SetIndent instructs the Encoder to format each subsequent encoded
+// value as prefix+indent, same as json.Encoder.SetIndent.
+func (e *Encoder) SetIndent(prefix, indent string) {
+	e.enc.SetIndent(prefix, indent)
+}
+
+// SetEscapeHTML specifies whether problematic HTML characters should be
+// escaped, same as json.Encoder.SetEscapeHTML.
+func (e *Encoder) SetEscapeHTML(on bool) {
+	e.enc.SetEscapeHTML(on)
+}
@@ -0,0 +1,77 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+type decoderTestRecord struct {
+	N Uint64 `json:"n"`
+}
+
+func TestDecoderDistinguishesAbsentNullPresent(t *testing.T) {
+	const input = `[{}, {"n": null}, {"n": 5}]`
+
+	dec := NewDecoder(strings.NewReader(input))
+
+	if _, err := dec.DecodeToken(); err != nil { // consume the opening '['
+		t.Fatalf("DecodeToken (array start): %v", err)
+	}
+
+	var got []decoderTestRecord
+	for dec.More() {
+		var rec decoderTestRecord
+		if err := dec.Decode(&rec); err != nil {
+			t.Fatalf("Decode: %v", err)
+		}
+		got = append(got, rec)
+	}
+	if len(got) != 3 {
+		t.Fatalf("decoded %d records; want 3", len(got))
+	}
+
+	if got[0].N.IsSet() {
+		t.Errorf("record 0 (absent field): IsSet() = true; want false")
+	}
+	if !got[1].N.IsSet() || got[1].N.Valid {
+		t.Errorf("record 1 (explicit null): IsSet()=%v, Valid=%v; want true, false", got[1].N.IsSet(), got[1].N.Valid)
+	}
+	if !got[2].N.IsSet() || !got[2].N.Valid || got[2].N.Uint64 != 5 {
+		t.Errorf("record 2 (present value): got %+v; want IsSet=true, Valid=true, Uint64=5", got[2].N)
+	}
+}
+
+func TestEncoderDecoderRoundTrip(t *testing.T) {
+	var buf strings.Builder
+	enc := NewEncoder(&buf)
+	if err := enc.Encode(decoderTestRecord{N: Uint64From(7)}); err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	dec := NewDecoder(strings.NewReader(buf.String()))
+	var rec decoderTestRecord
+	if err := dec.Decode(&rec); err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !rec.N.Valid || rec.N.Uint64 != 7 {
+		t.Errorf("round trip = %+v; want Valid=true, Uint64=7", rec.N)
+	}
+}
+
+func TestDecoderUseNumberPreservesPrecision(t *testing.T) {
+	dec := NewDecoder(strings.NewReader(`18446744073709551615`))
+	dec.UseNumber()
+
+	tok, err := dec.DecodeToken()
+	if err != nil {
+		t.Fatalf("DecodeToken: %v", err)
+	}
+	num, ok := tok.(json.Number)
+	if !ok {
+		t.Fatalf("DecodeToken() = %T; want json.Number", tok)
+	}
+	if num.String() != "18446744073709551615" {
+		t.Errorf("DecodeToken() = %s; want 18446744073709551615", num.String())
+	}
+}
@@ -0,0 +1,40 @@
+package null
+
+// EncodingMode selects how null types marshal an invalid value to JSON.
+type EncodingMode int
+
+const (
+	// NullEncoding marshals an invalid value as JSON null. This is the
+	// package default and mirrors the behavior of the "null" package in
+	// lbry/null-extended.
+	NullEncoding EncodingMode = iota
+	// ZeroEncoding marshals an invalid value as its Go zero value (0, "",
+	// false, ...) instead of null, mirroring the separate "zero" package
+	// in lbry/null-extended. The mode does not affect unmarshaling: Uint
+	// and Uint64 unmarshal a blank JSON string as an invalid value, while
+	// Int16 returns an error for it.
+	ZeroEncoding
+)
+
+// encodingMode is the package-wide mode used by every null type's
+// MarshalJSON. It defaults to NullEncoding for backward compatibility.
+var encodingMode = NullEncoding
+
+// SetEncoding sets the package-wide EncodingMode used when marshaling
+// invalid values to JSON. It replaces the need to import a parallel
+// "zero value" package alongside this one. It is not safe to call
+// concurrently with marshaling.
+func SetEncoding(mode EncodingMode) {
+	encodingMode = mode
+}
+
+// Encoding returns the package's current EncodingMode.
+func Encoding() EncodingMode {
+	return encodingMode
+}
+
+// TreatZeroAsNull reproduces the old, buggy Uint/Uint64 behavior where
+// unmarshaling a JSON 0 left the value invalid instead of valid-and-zero.
+// It defaults to false; leave it false unless code elsewhere depends on
+// the old behavior.
+var TreatZeroAsNull = false
@@ -0,0 +1,53 @@
+package null
+
+import "testing"
+
+func TestEncodingModeMarshalJSON(t *testing.T) {
+	defer SetEncoding(NullEncoding)
+
+	tests := []struct {
+		name string
+		mode EncodingMode
+		want string
+	}{
+		{"null preserving", NullEncoding, "null"},
+		{"zero value", ZeroEncoding, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetEncoding(tt.mode)
+
+			var u Uint
+			if got, err := u.MarshalJSON(); err != nil || string(got) != tt.want {
+				t.Errorf("Uint.MarshalJSON() = %q, %v; want %q, nil", got, err, tt.want)
+			}
+			var u64 Uint64
+			if got, err := u64.MarshalJSON(); err != nil || string(got) != tt.want {
+				t.Errorf("Uint64.MarshalJSON() = %q, %v; want %q, nil", got, err, tt.want)
+			}
+			var i16 Int16
+			if got, err := i16.MarshalJSON(); err != nil || string(got) != tt.want {
+				t.Errorf("Int16.MarshalJSON() = %q, %v; want %q, nil", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodingModeDoesNotAffectValidValues(t *testing.T) {
+	defer SetEncoding(NullEncoding)
+
+	for _, mode := range []EncodingMode{NullEncoding, ZeroEncoding} {
+		SetEncoding(mode)
+
+		if got, _ := UintFrom(5).MarshalJSON(); string(got) != "5" {
+			t.Errorf("mode %v: Uint.MarshalJSON() = %q; want \"5\"", mode, got)
+		}
+		if got, _ := Uint64From(5).MarshalJSON(); string(got) != "5" {
+			t.Errorf("mode %v: Uint64.MarshalJSON() = %q; want \"5\"", mode, got)
+		}
+		if got, _ := Int16From(5).MarshalJSON(); string(got) != "5" {
+			t.Errorf("mode %v: Int16.MarshalJSON() = %q; want \"5\"", mode, got)
+		}
+	}
+}
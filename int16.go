@@ -84,6 +84,9 @@ func (i *Int16) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 func (i Int16) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
+		if encodingMode == ZeroEncoding {
+			return []byte("0"), nil
+		}
 		return NullBytes, nil
 	}
 	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
@@ -135,6 +138,30 @@ func (i Int16) Value() (driver.Value, error) {
 	return int64(i.Int16), nil
 }
 
+// FromDB implements xorm's Conversion interface. Together with Scan and
+// Value above, it gives Int16 identical null handling whether an ORM
+// goes through database/sql or calls FromDB/ToDB directly.
+func (i *Int16) FromDB(b []byte) error {
+	if b == nil {
+		i.Int16, i.Valid, i.set = 0, false, false
+		return nil
+	}
+	n, err := strconv.ParseInt(string(b), 10, 16)
+	if err != nil {
+		return err
+	}
+	i.Int16, i.Valid, i.set = int16(n), true, true
+	return nil
+}
+
+// ToDB implements xorm's Conversion interface.
+func (i Int16) ToDB() ([]byte, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return []byte(strconv.FormatInt(int64(i.Int16), 10)), nil
+}
+
 // Randomize for sqlboiler
 func (i *Int16) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
@@ -0,0 +1,6 @@
+package null
+
+// NullBytes is the JSON encoding of a null value. Every null type's
+// MarshalJSON and UnmarshalJSON compare against it to represent an
+// invalid value.
+var NullBytes = []byte("null")
@@ -8,13 +8,14 @@ import (
 	"reflect"
 	"strconv"
 
-	"github.com/volatiletech/null/convert"
+	"github.com/razor-1/null/v9/convert"
 )
 
 // Uint is an nullable uint.
 type Uint struct {
 	Uint  uint
 	Valid bool
+	set   bool
 }
 
 // NewUint creates a new Uint
@@ -22,6 +23,7 @@ func NewUint(i uint, valid bool) Uint {
 	return Uint{
 		Uint:  i,
 		Valid: valid,
+		set:   true,
 	}
 }
 
@@ -38,8 +40,16 @@ func UintFromPtr(i *uint) Uint {
 	return NewUint(*i, true)
 }
 
+// IsSet returns whether this Uint was explicitly set (as opposed to its
+// zero value) by Unmarshal*, Scan, or SetValid. Scan and FromDB of a SQL
+// NULL leave it false.
+func (u Uint) IsSet() bool {
+	return u.set
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (u *Uint) UnmarshalJSON(data []byte) error {
+	u.set = true
 	if bytes.Equal(data, NullBytes) {
 		u.Valid = false
 		u.Uint = 0
@@ -73,12 +83,13 @@ func (u *Uint) UnmarshalJSON(data []byte) error {
 	}
 
 	u.Uint = uint(i)
-	u.Valid = (err == nil) && (u.Uint != 0)
+	u.Valid = err == nil && !(TreatZeroAsNull && u.Uint == 0)
 	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (u *Uint) UnmarshalText(text []byte) error {
+	u.set = true
 	if text == nil || len(text) == 0 {
 		u.Valid = false
 		return nil
@@ -95,6 +106,9 @@ func (u *Uint) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 func (u Uint) MarshalJSON() ([]byte, error) {
 	if !u.Valid {
+		if encodingMode == ZeroEncoding {
+			return []byte("0"), nil
+		}
 		return NullBytes, nil
 	}
 	return []byte(strconv.FormatUint(uint64(u.Uint), 10)), nil
@@ -112,6 +126,7 @@ func (u Uint) MarshalText() ([]byte, error) {
 func (u *Uint) SetValid(n uint) {
 	u.Uint = n
 	u.Valid = true
+	u.set = true
 }
 
 // Ptr returns a pointer to this Uint's value, or a nil pointer if this Uint is null.
@@ -130,10 +145,10 @@ func (u Uint) IsZero() bool {
 // Scan implements the Scanner interface.
 func (u *Uint) Scan(value interface{}) error {
 	if value == nil {
-		u.Uint, u.Valid = 0, false
+		u.Uint, u.Valid, u.set = 0, false, false
 		return nil
 	}
-	u.Valid = true
+	u.Valid, u.set = true, true
 	return convert.ConvertAssign(&u.Uint, value)
 }
 
@@ -145,6 +160,30 @@ func (u Uint) Value() (driver.Value, error) {
 	return int64(u.Uint), nil
 }
 
+// FromDB implements xorm's Conversion interface. Together with Scan and
+// Value above, it gives Uint identical null handling whether an ORM goes
+// through database/sql or calls FromDB/ToDB directly.
+func (u *Uint) FromDB(b []byte) error {
+	if b == nil {
+		u.Uint, u.Valid, u.set = 0, false, false
+		return nil
+	}
+	n, err := strconv.ParseUint(string(b), 10, 0)
+	if err != nil {
+		return err
+	}
+	u.Uint, u.Valid, u.set = uint(n), true, true
+	return nil
+}
+
+// ToDB implements xorm's Conversion interface.
+func (u Uint) ToDB() ([]byte, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return []byte(strconv.FormatUint(uint64(u.Uint), 10)), nil
+}
+
 // Randomize for sqlboiler
 func (u *Uint) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
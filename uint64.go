@@ -8,13 +8,14 @@ import (
 	"reflect"
 	"strconv"
 
-	"github.com/volatiletech/null/v8/convert"
+	"github.com/razor-1/null/v9/convert"
 )
 
 // Uint64 is an nullable uint64.
 type Uint64 struct {
 	Uint64 uint64
 	Valid  bool
+	set    bool
 }
 
 // NewUint64 creates a new Uint64
@@ -22,6 +23,7 @@ func NewUint64(i uint64, valid bool) Uint64 {
 	return Uint64{
 		Uint64: i,
 		Valid:  valid,
+		set:    true,
 	}
 }
 
@@ -38,30 +40,43 @@ func Uint64FromPtr(i *uint64) Uint64 {
 	return NewUint64(*i, true)
 }
 
+// IsSet returns whether this Uint64 was explicitly set (as opposed to its
+// zero value) by Unmarshal*, Scan, or SetValid. Scan and FromDB of a SQL
+// NULL leave it false.
+func (u Uint64) IsSet() bool {
+	return u.set
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (u *Uint64) UnmarshalJSON(data []byte) error {
+	u.set = true
 	if bytes.Equal(data, NullBytes) {
 		u.Uint64 = 0
 		u.Valid = false
 		return nil
 	}
 
-	var err error
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
 	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
+	if err := dec.Decode(&v); err != nil {
 		return err
 	}
+
+	var err error
 	switch x := v.(type) {
-	case float64:
-		// Unmarshal again, directly to uint64, to avoid intermediate float64
-		err = json.Unmarshal(data, &u.Uint64)
+	case json.Number:
+		// Parse the number's literal digits directly instead of going
+		// through float64, so values beyond 2^53 and up to
+		// math.MaxUint64 round-trip losslessly.
+		u.Uint64, err = strconv.ParseUint(x.String(), 10, 64)
 	case string:
-		str := string(x)
-		if len(str) == 0 {
+		if len(x) == 0 {
 			u.Valid = false
 			return nil
 		}
-		u.Uint64, err = strconv.ParseUint(str, 10, 64)
+		u.Uint64, err = strconv.ParseUint(x, 10, 64)
 	case nil:
 		u.Valid = false
 		return nil
@@ -69,12 +84,13 @@ func (u *Uint64) UnmarshalJSON(data []byte) error {
 		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Uint64", reflect.TypeOf(v).Name())
 	}
 
-	u.Valid = (err == nil) && (u.Uint64 != 0)
+	u.Valid = err == nil && !(TreatZeroAsNull && u.Uint64 == 0)
 	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 func (u *Uint64) UnmarshalText(text []byte) error {
+	u.set = true
 	if text == nil || len(text) == 0 {
 		u.Valid = false
 		return nil
@@ -91,6 +107,9 @@ func (u *Uint64) UnmarshalText(text []byte) error {
 // MarshalJSON implements json.Marshaler.
 func (u Uint64) MarshalJSON() ([]byte, error) {
 	if !u.Valid {
+		if encodingMode == ZeroEncoding {
+			return []byte("0"), nil
+		}
 		return NullBytes, nil
 	}
 	return []byte(strconv.FormatUint(u.Uint64, 10)), nil
@@ -108,6 +127,7 @@ func (u Uint64) MarshalText() ([]byte, error) {
 func (u *Uint64) SetValid(n uint64) {
 	u.Uint64 = n
 	u.Valid = true
+	u.set = true
 }
 
 // Ptr returns a pointer to this Uint64's value, or a nil pointer if this Uint64 is null.
@@ -126,10 +146,10 @@ func (u Uint64) IsZero() bool {
 // Scan implements the Scanner interface.
 func (u *Uint64) Scan(value interface{}) error {
 	if value == nil {
-		u.Uint64, u.Valid = 0, false
+		u.Uint64, u.Valid, u.set = 0, false, false
 		return nil
 	}
-	u.Valid = true
+	u.Valid, u.set = true, true
 
 	// If value is negative int64, convert it to uint64
 	if i, ok := value.(int64); ok && i < 0 {
@@ -153,6 +173,30 @@ func (u Uint64) Value() (driver.Value, error) {
 	return int64(u.Uint64), nil
 }
 
+// FromDB implements xorm's Conversion interface. Together with Scan and
+// Value above, it gives Uint64 identical null handling whether an ORM
+// goes through database/sql or calls FromDB/ToDB directly.
+func (u *Uint64) FromDB(b []byte) error {
+	if b == nil {
+		u.Uint64, u.Valid, u.set = 0, false, false
+		return nil
+	}
+	n, err := strconv.ParseUint(string(b), 10, 64)
+	if err != nil {
+		return err
+	}
+	u.Uint64, u.Valid, u.set = n, true, true
+	return nil
+}
+
+// ToDB implements xorm's Conversion interface.
+func (u Uint64) ToDB() ([]byte, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return []byte(strconv.FormatUint(u.Uint64, 10)), nil
+}
+
 // Randomize for sqlboiler
 func (u *Uint64) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
 	if shouldBeNull {
@@ -0,0 +1,89 @@
+package null
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestUint64RoundTrip(t *testing.T) {
+	defer func() { TreatZeroAsNull = false }()
+
+	for _, n := range []uint64{0, 1, 42, 1<<63 - 1} {
+		var u Uint64
+		data, err := Uint64From(n).MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON(%d): %v", n, err)
+		}
+		if err := u.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%q): %v", data, err)
+		}
+		if !u.Valid || u.Uint64 != n {
+			t.Fatalf("UnmarshalJSON(%q) = {%d, valid=%v}; want {%d, valid=true}", data, u.Uint64, u.Valid, n)
+		}
+
+		v, err := u.Value()
+		if err != nil {
+			t.Fatalf("Value(%d): %v", n, err)
+		}
+		var scanned Uint64
+		if err := scanned.Scan(v); err != nil {
+			t.Fatalf("Scan(%v): %v", v, err)
+		}
+		if !scanned.Valid || scanned.Uint64 != n {
+			t.Fatalf("Scan(%v) = {%d, valid=%v}; want {%d, valid=true}", v, scanned.Uint64, scanned.Valid, n)
+		}
+	}
+}
+
+func TestUint64ZeroIsValidByDefault(t *testing.T) {
+	defer func() { TreatZeroAsNull = false }()
+
+	var u Uint64
+	if err := u.UnmarshalJSON([]byte("0")); err != nil {
+		t.Fatalf("UnmarshalJSON(\"0\"): %v", err)
+	}
+	if !u.Valid || u.Uint64 != 0 {
+		t.Errorf("UnmarshalJSON(\"0\") = {%d, valid=%v}; want {0, valid=true}", u.Uint64, u.Valid)
+	}
+}
+
+func TestUint64TreatZeroAsNull(t *testing.T) {
+	TreatZeroAsNull = true
+	defer func() { TreatZeroAsNull = false }()
+
+	var u Uint64
+	if err := u.UnmarshalJSON([]byte("0")); err != nil {
+		t.Fatalf("UnmarshalJSON(\"0\"): %v", err)
+	}
+	if u.Valid {
+		t.Errorf("UnmarshalJSON(\"0\") with TreatZeroAsNull = {%d, valid=true}; want valid=false", u.Uint64)
+	}
+}
+
+// TestUint64UnmarshalJSONPreservesPrecision guards against the old bug
+// where going through interface{}/float64 silently truncated values
+// above 2^53. Note: this module has no Int64 or other 64-bit signed
+// type, so only Uint64 is covered here.
+func TestUint64UnmarshalJSONPreservesPrecision(t *testing.T) {
+	tests := []string{
+		"18446744073709551615", // math.MaxUint64
+		"9223372036854775808",  // math.MaxInt64 + 1
+	}
+
+	for _, data := range tests {
+		var u Uint64
+		if err := u.UnmarshalJSON([]byte(data)); err != nil {
+			t.Fatalf("UnmarshalJSON(%q): %v", data, err)
+		}
+		if got := strconv.FormatUint(u.Uint64, 10); got != data {
+			t.Errorf("UnmarshalJSON(%q) = %s; want %s", data, got, data)
+		}
+	}
+}
+
+func TestUint64UnmarshalJSONRejectsNegative(t *testing.T) {
+	var u Uint64
+	if err := u.UnmarshalJSON([]byte("-1")); err == nil {
+		t.Error("UnmarshalJSON(\"-1\") = nil error; want an error")
+	}
+}
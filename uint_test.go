@@ -0,0 +1,69 @@
+package null
+
+import "testing"
+
+func TestUintRoundTrip(t *testing.T) {
+	defer func() { TreatZeroAsNull = false }()
+
+	for _, n := range []uint{0, 1, 42, 1<<32 - 1} {
+		var u Uint
+		data, err := UintFrom(n).MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON(%d): %v", n, err)
+		}
+		if err := u.UnmarshalJSON(data); err != nil {
+			t.Fatalf("UnmarshalJSON(%q): %v", data, err)
+		}
+		if !u.Valid || u.Uint != n {
+			t.Fatalf("UnmarshalJSON(%q) = {%d, valid=%v}; want {%d, valid=true}", data, u.Uint, u.Valid, n)
+		}
+
+		v, err := u.Value()
+		if err != nil {
+			t.Fatalf("Value(%d): %v", n, err)
+		}
+		var scanned Uint
+		if err := scanned.Scan(v); err != nil {
+			t.Fatalf("Scan(%v): %v", v, err)
+		}
+		if !scanned.Valid || scanned.Uint != n {
+			t.Fatalf("Scan(%v) = {%d, valid=%v}; want {%d, valid=true}", v, scanned.Uint, scanned.Valid, n)
+		}
+	}
+}
+
+func TestUintZeroIsValidByDefault(t *testing.T) {
+	defer func() { TreatZeroAsNull = false }()
+
+	var u Uint
+	if err := u.UnmarshalJSON([]byte("0")); err != nil {
+		t.Fatalf("UnmarshalJSON(\"0\"): %v", err)
+	}
+	if !u.Valid || u.Uint != 0 {
+		t.Errorf("UnmarshalJSON(\"0\") = {%d, valid=%v}; want {0, valid=true}", u.Uint, u.Valid)
+	}
+}
+
+func TestUintTreatZeroAsNull(t *testing.T) {
+	TreatZeroAsNull = true
+	defer func() { TreatZeroAsNull = false }()
+
+	var u Uint
+	if err := u.UnmarshalJSON([]byte("0")); err != nil {
+		t.Fatalf("UnmarshalJSON(\"0\"): %v", err)
+	}
+	if u.Valid {
+		t.Errorf("UnmarshalJSON(\"0\") with TreatZeroAsNull = {%d, valid=true}; want valid=false", u.Uint)
+	}
+}
+
+func TestUintUnmarshalNull(t *testing.T) {
+	var u Uint
+	u.Valid = true
+	if err := u.UnmarshalJSON(NullBytes); err != nil {
+		t.Fatalf("UnmarshalJSON(null): %v", err)
+	}
+	if u.Valid || u.Uint != 0 {
+		t.Errorf("UnmarshalJSON(null) = {%d, valid=%v}; want {0, valid=false}", u.Uint, u.Valid)
+	}
+}